@@ -0,0 +1,62 @@
+// Copyright (c) 2022, Geert JM Vanderkelen
+
+package urn
+
+import (
+	"fmt"
+	"strings"
+)
+
+// nssSubDelims are the sub-delimiters RFC 8141 allows unencoded in the NSS,
+// in addition to the unreserved characters.
+const nssSubDelims = "!$&'()*+,;=:@/"
+
+// EncodeNSS percent-encodes raw so the result is a valid NSS: unreserved
+// characters (ALPHA / DIGIT / "-" / "." / "_" / "~") and the sub-delimiters
+// allowed by RFC 8141 ("!$&'()*+,;=:@/") are left as-is, everything else -
+// including the individual UTF-8 bytes of non-ASCII runes - is %HH-encoded.
+func EncodeNSS(raw string) string {
+	var b strings.Builder
+	b.Grow(len(raw))
+
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+		if isUnreservedByte(c) || strings.IndexByte(nssSubDelims, c) >= 0 {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
+
+// DecodeNSS reverses EncodeNSS, decoding every percent-encoded triplet in
+// nss. It returns an error when nss contains a malformed "%" sequence.
+func DecodeNSS(nss string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(nss))
+
+	for i := 0; i < len(nss); i++ {
+		if nss[i] != '%' {
+			b.WriteByte(nss[i])
+			continue
+		}
+
+		if i+2 >= len(nss) || !isHexDigit(nss[i+1]) || !isHexDigit(nss[i+2]) {
+			return "", fmt.Errorf("malformed percent-encoding in NSS at position %d", i)
+		}
+
+		b.WriteByte(hexDigitVal(nss[i+1])<<4 | hexDigitVal(nss[i+2]))
+		i += 2
+	}
+
+	return b.String(), nil
+}
+
+// NewFromRaw is a convenience around New that percent-encodes rawNSS with
+// EncodeNSS before constructing the URN, so callers with user-supplied
+// identifiers do not have to pre-encode them.
+func NewFromRaw(nid, rawNSS string, options ...Option) (*URN, error) {
+	return New(nid, EncodeNSS(rawNSS), options...)
+}
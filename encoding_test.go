@@ -0,0 +1,66 @@
+// Copyright (c) 2022, Geert JM Vanderkelen
+
+package urn
+
+import (
+	"testing"
+
+	"github.com/geertjanvdk/xkit/xt"
+)
+
+func TestEncodeNSS(t *testing.T) {
+	t.Run("leaves unreserved characters and sub-delims as-is", func(t *testing.T) {
+		xt.Eq(t, "abc-._~!$&'()*+,;=:@/XYZ09", EncodeNSS("abc-._~!$&'()*+,;=:@/XYZ09"))
+	})
+
+	t.Run("encodes spaces", func(t *testing.T) {
+		xt.Eq(t, "a%20b", EncodeNSS("a b"))
+	})
+
+	t.Run("encodes a literal percent sign", func(t *testing.T) {
+		xt.Eq(t, "100%25", EncodeNSS("100%"))
+	})
+
+	t.Run("encodes UTF-8 bytes of non-ASCII runes", func(t *testing.T) {
+		xt.Eq(t, "caf%C3%A9", EncodeNSS("café"))
+	})
+}
+
+func TestDecodeNSS(t *testing.T) {
+	t.Run("decodes percent-encoded triplets", func(t *testing.T) {
+		got, err := DecodeNSS("a%20b")
+		xt.OK(t, err)
+		xt.Eq(t, "a b", got)
+	})
+
+	t.Run("decodes UTF-8 bytes of non-ASCII runes", func(t *testing.T) {
+		got, err := DecodeNSS("caf%C3%A9")
+		xt.OK(t, err)
+		xt.Eq(t, "café", got)
+	})
+
+	t.Run("round-trips with EncodeNSS", func(t *testing.T) {
+		raw := "héllo, world! 100%"
+		got, err := DecodeNSS(EncodeNSS(raw))
+		xt.OK(t, err)
+		xt.Eq(t, raw, got)
+	})
+
+	t.Run("rejects truncated percent-encoding", func(t *testing.T) {
+		_, err := DecodeNSS("a%2")
+		xt.KO(t, err)
+	})
+
+	t.Run("rejects non-hexadecimal percent-encoding", func(t *testing.T) {
+		_, err := DecodeNSS("a%zz")
+		xt.KO(t, err)
+	})
+}
+
+func TestNewFromRaw(t *testing.T) {
+	t.Run("encodes the raw NSS before constructing the URN", func(t *testing.T) {
+		u, err := NewFromRaw("example", "hello, world!")
+		xt.OK(t, err)
+		xt.Eq(t, "hello,%20world!", u.NSS)
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2022, Geert JM Vanderkelen
+
+package urn
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// NIDSpec adds namespace-specific rules on top of the generic RFC 8141
+// grammar. It is registered for one or more NIDs using RegisterNID.
+type NIDSpec interface {
+	// Validate reports whether nss is acceptable for the namespace. It is
+	// only consulted when New or Parse are called with WithStrictNID.
+	Validate(nss string) error
+
+	// Normalize returns the canonical form of nss for the namespace. It is
+	// always applied by New and Parse when a spec is registered for the NID.
+	Normalize(nss string) (string, error)
+}
+
+var (
+	nidRegistryMu sync.RWMutex
+	nidRegistry   = map[string]NIDSpec{}
+)
+
+// RegisterNID registers spec as the NIDSpec used for nid by New and Parse.
+// nid is matched case-insensitively. Registering a spec for a nid that
+// already has one replaces it.
+func RegisterNID(nid string, spec NIDSpec) {
+	nidRegistryMu.Lock()
+	defer nidRegistryMu.Unlock()
+	nidRegistry[strings.ToLower(nid)] = spec
+}
+
+// lookupNIDSpec returns the NIDSpec registered for nid, if any.
+func lookupNIDSpec(nid string) (NIDSpec, bool) {
+	nidRegistryMu.RLock()
+	defer nidRegistryMu.RUnlock()
+	spec, ok := nidRegistry[strings.ToLower(nid)]
+	return spec, ok
+}
+
+// applyNIDSpec normalizes nss using the NIDSpec registered for nid, if any,
+// and, when strict is true, validates the (possibly normalized) nss,
+// returning an error when the spec rejects it.
+func applyNIDSpec(nid, nss string, strict bool) (string, error) {
+	spec, ok := lookupNIDSpec(nid)
+	if !ok {
+		return nss, nil
+	}
+
+	norm, err := spec.Normalize(nss)
+	if err != nil {
+		if strict {
+			return nss, err
+		}
+	} else {
+		nss = norm
+	}
+
+	if strict {
+		if err := spec.Validate(nss); err != nil {
+			return nss, err
+		}
+	}
+
+	return nss, nil
+}
+
+func init() {
+	RegisterNID("uuid", uuidSpec{})
+	RegisterNID("isbn", isbnSpec{})
+	RegisterNID("oid", oidSpec{})
+}
+
+// uuidSpec implements NIDSpec for the "uuid" namespace (RFC 4122).
+type uuidSpec struct{}
+
+var reUUID = regexp.MustCompile(`(?i)^([0-9a-f]{8})-([0-9a-f]{4})-([0-9a-f]{4})-([0-9a-f]{4})-([0-9a-f]{12})$`)
+
+func (uuidSpec) Validate(nss string) error {
+	if !reUUID.MatchString(nss) {
+		return fmt.Errorf("invalid uuid NSS, expected 8-4-4-4-12 hexadecimal form")
+	}
+	return nil
+}
+
+// Normalize strips any hyphens from nss, re-inserts them at the canonical
+// positions, and lower-cases the result, e.g. "A1B2..." becomes "a1b2-...".
+func (uuidSpec) Normalize(nss string) (string, error) {
+	hex := strings.ToLower(strings.ReplaceAll(nss, "-", ""))
+	if len(hex) != 32 {
+		return "", fmt.Errorf("invalid uuid NSS, expected 32 hexadecimal digits")
+	}
+
+	for _, r := range hex {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return "", fmt.Errorf("invalid uuid NSS, contains non-hexadecimal character %q", r)
+		}
+	}
+
+	return hex[0:8] + "-" + hex[8:12] + "-" + hex[12:16] + "-" + hex[16:20] + "-" + hex[20:32], nil
+}
+
+// isbnSpec implements NIDSpec for the "isbn" namespace (ISBN-10/ISBN-13).
+type isbnSpec struct{}
+
+func (isbnSpec) Validate(nss string) error {
+	digits := strings.ReplaceAll(nss, "-", "")
+
+	switch len(digits) {
+	case 10:
+		if !isValidISBN10(digits) {
+			return fmt.Errorf("invalid isbn NSS, bad ISBN-10 check digit")
+		}
+	case 13:
+		if !isValidISBN13(digits) {
+			return fmt.Errorf("invalid isbn NSS, bad ISBN-13 check digit")
+		}
+	default:
+		return fmt.Errorf("invalid isbn NSS, expected 10 or 13 digits")
+	}
+
+	return nil
+}
+
+// Normalize returns nss unchanged; both hyphenated and bare-digit ISBNs
+// are valid representations, so no canonical form is forced on callers.
+func (isbnSpec) Normalize(nss string) (string, error) {
+	return nss, nil
+}
+
+func isValidISBN10(digits string) bool {
+	if len(digits) != 10 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 9; i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return false
+		}
+		sum += int(digits[i]-'0') * (10 - i)
+	}
+
+	last := digits[9]
+	switch {
+	case last >= '0' && last <= '9':
+		sum += int(last - '0')
+	case last == 'X' || last == 'x':
+		sum += 10
+	default:
+		return false
+	}
+
+	return sum%11 == 0
+}
+
+func isValidISBN13(digits string) bool {
+	if len(digits) != 13 {
+		return false
+	}
+
+	sum := 0
+	for i, c := range digits {
+		if c < '0' || c > '9' {
+			return false
+		}
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += int(c-'0') * weight
+	}
+
+	return sum%10 == 0
+}
+
+// oidSpec implements NIDSpec for the "oid" namespace (ITU-T X.660
+// dotted-decimal object identifiers).
+type oidSpec struct{}
+
+var reOID = regexp.MustCompile(`^(0|[1-9][0-9]*)(\.(0|[1-9][0-9]*))+$`)
+
+func (oidSpec) Validate(nss string) error {
+	if !reOID.MatchString(nss) {
+		return fmt.Errorf("invalid oid NSS, expected dotted-decimal arcs without leading zeros")
+	}
+	return nil
+}
+
+// Normalize returns nss unchanged; dotted-decimal OIDs have no alternate
+// representations to canonicalize.
+func (oidSpec) Normalize(nss string) (string, error) {
+	return nss, nil
+}
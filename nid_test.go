@@ -0,0 +1,88 @@
+// Copyright (c) 2022, Geert JM Vanderkelen
+
+package urn
+
+import (
+	"testing"
+
+	"github.com/geertjanvdk/xkit/xt"
+)
+
+func TestRegisterNID(t *testing.T) {
+	t.Run("registered spec normalizes leniently by default", func(t *testing.T) {
+		u, err := New("uuid", "F47AC10B-58CC-4372-A567-0E02B2C3D479")
+		xt.OK(t, err)
+		xt.Eq(t, "f47ac10b-58cc-4372-a567-0e02b2c3d479", u.NSS)
+	})
+
+	t.Run("lenient mode does not fail on invalid NSS", func(t *testing.T) {
+		_, err := New("oid", "1.02.3")
+		xt.OK(t, err)
+	})
+
+	t.Run("strict mode fails on invalid NSS", func(t *testing.T) {
+		_, err := New("oid", "1.02.3", WithStrictNID())
+		xt.KO(t, err)
+	})
+
+	t.Run("strict mode passes valid NSS", func(t *testing.T) {
+		u, err := New("oid", "1.2.840.113549", WithStrictNID())
+		xt.OK(t, err)
+		xt.Eq(t, "1.2.840.113549", u.NSS)
+	})
+}
+
+func TestUUIDSpec(t *testing.T) {
+	t.Run("normalize canonicalizes case and dashes", func(t *testing.T) {
+		got, err := uuidSpec{}.Normalize("F47AC10B58CC4372A5670E02B2C3D479")
+		xt.OK(t, err)
+		xt.Eq(t, "f47ac10b-58cc-4372-a567-0e02b2c3d479", got)
+	})
+
+	t.Run("normalize rejects wrong length", func(t *testing.T) {
+		_, err := uuidSpec{}.Normalize("too-short")
+		xt.KO(t, err)
+	})
+
+	t.Run("validate accepts canonical form", func(t *testing.T) {
+		xt.OK(t, uuidSpec{}.Validate("f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+	})
+
+	t.Run("validate rejects malformed form", func(t *testing.T) {
+		xt.KO(t, uuidSpec{}.Validate("f47ac10b-58cc-4372-a567"))
+	})
+}
+
+func TestISBNSpec(t *testing.T) {
+	t.Run("validate accepts ISBN-10 with valid check digit", func(t *testing.T) {
+		xt.OK(t, isbnSpec{}.Validate("0-13-468599-7"))
+	})
+
+	t.Run("validate accepts ISBN-13 with valid check digit", func(t *testing.T) {
+		xt.OK(t, isbnSpec{}.Validate("978-0135800911"))
+	})
+
+	t.Run("validate rejects bad check digit", func(t *testing.T) {
+		xt.KO(t, isbnSpec{}.Validate("978-0135800912"))
+	})
+
+	t.Run("normalize leaves hyphenation as-is", func(t *testing.T) {
+		got, err := isbnSpec{}.Normalize("978-0135800911")
+		xt.OK(t, err)
+		xt.Eq(t, "978-0135800911", got)
+	})
+}
+
+func TestOIDSpec(t *testing.T) {
+	t.Run("validate accepts dotted-decimal arcs", func(t *testing.T) {
+		xt.OK(t, oidSpec{}.Validate("1.2.840.113549"))
+	})
+
+	t.Run("validate rejects leading zero", func(t *testing.T) {
+		xt.KO(t, oidSpec{}.Validate("1.02.3"))
+	})
+
+	t.Run("validate rejects non-numeric arc", func(t *testing.T) {
+		xt.KO(t, oidSpec{}.Validate("1.a.3"))
+	})
+}
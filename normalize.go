@@ -0,0 +1,106 @@
+// Copyright (c) 2022, Geert JM Vanderkelen
+
+package urn
+
+import "strings"
+
+// Normalize returns a new URN with u's NID lower-cased and its NSS put in
+// the canonical form defined by RFC 8141 §3.1: percent-encoded triplets
+// are upper-cased, and any triplet that percent-encodes an unreserved
+// character (ALPHA / DIGIT / "-" / "." / "_" / "~") is decoded to that
+// literal character. The case of the remaining literal NSS, and the r-,
+// q-, and f-components, are copied unchanged.
+func (u *URN) Normalize() *URN {
+	return &URN{
+		NID:        strings.ToLower(u.NID),
+		NSS:        normalizeNSS(u.NSS),
+		rComponent: u.rComponent,
+		qComponent: u.qComponent,
+		fComponent: u.fComponent,
+	}
+}
+
+// NormalizeString parses s as a URN and returns the string form of its
+// RFC 8141 §3.1 canonical form, as produced by Normalize.
+func NormalizeString(s string) (string, error) {
+	u, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	if u.IsZero() {
+		return "", nil
+	}
+
+	return u.Normalize().String(), nil
+}
+
+// CanonicalString returns u's normalized "urn:<nid>:<nss>" form, excluding
+// the r-, q-, and f-components since RFC 8141 excludes them from
+// lexical equivalence. It is suitable as a stable map key or for a
+// database uniqueness constraint.
+func (u *URN) CanonicalString() string {
+	n := u.Normalize()
+	return "urn:" + n.NID + ":" + n.NSS
+}
+
+// normalizeNSS upper-cases the hex digits of percent-encoded triplets and
+// decodes the ones that percent-encode an unreserved character.
+func normalizeNSS(nss string) string {
+	var b strings.Builder
+	b.Grow(len(nss))
+
+	for i := 0; i < len(nss); i++ {
+		if nss[i] != '%' || i+2 >= len(nss) || !isHexDigit(nss[i+1]) || !isHexDigit(nss[i+2]) {
+			b.WriteByte(nss[i])
+			continue
+		}
+
+		decoded := hexDigitVal(nss[i+1])<<4 | hexDigitVal(nss[i+2])
+		if isUnreservedByte(decoded) {
+			b.WriteByte(decoded)
+		} else {
+			b.WriteByte('%')
+			b.WriteByte(toUpperHexDigit(nss[i+1]))
+			b.WriteByte(toUpperHexDigit(nss[i+2]))
+		}
+		i += 2
+	}
+
+	return b.String()
+}
+
+// isUnreservedByte reports whether b is one of RFC 3986's unreserved
+// characters: ALPHA / DIGIT / "-" / "." / "_" / "~".
+func isUnreservedByte(b byte) bool {
+	switch {
+	case b >= 'a' && b <= 'z', b >= 'A' && b <= 'Z', b >= '0' && b <= '9':
+		return true
+	case b == '-' || b == '.' || b == '_' || b == '~':
+		return true
+	default:
+		return false
+	}
+}
+
+func isHexDigit(b byte) bool {
+	return (b >= '0' && b <= '9') || (b >= 'a' && b <= 'f') || (b >= 'A' && b <= 'F')
+}
+
+func hexDigitVal(b byte) byte {
+	switch {
+	case b >= '0' && b <= '9':
+		return b - '0'
+	case b >= 'a' && b <= 'f':
+		return b - 'a' + 10
+	default:
+		return b - 'A' + 10
+	}
+}
+
+func toUpperHexDigit(b byte) byte {
+	if b >= 'a' && b <= 'f' {
+		return b - 'a' + 'A'
+	}
+	return b
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2022, Geert JM Vanderkelen
+
+package urn
+
+import (
+	"testing"
+
+	"github.com/geertjanvdk/xkit/xt"
+)
+
+func TestURN_Normalize(t *testing.T) {
+	t.Run("lower-cases NID and decodes unreserved percent-encoding", func(t *testing.T) {
+		u := mustParseURN("urn:EXAMPLE:%7ea", WithNotLowerCaseNSS())
+		n := u.Normalize()
+		xt.Eq(t, "example", n.NID)
+		xt.Eq(t, "~a", n.NSS)
+	})
+
+	t.Run("upper-cases percent-encoded triplets that stay encoded", func(t *testing.T) {
+		u := mustParseURN("urn:example:a123%2cz456")
+		n := u.Normalize()
+		xt.Eq(t, "a123%2Cz456", n.NSS)
+	})
+
+	t.Run("preserves literal NSS case and components", func(t *testing.T) {
+		u := mustParseURN("urn:example:MixedCase#section-3")
+		n := u.Normalize()
+		xt.Eq(t, "MixedCase", n.NSS)
+		xt.Eq(t, "section-3", n.FComponent())
+	})
+}
+
+func TestNormalizeString(t *testing.T) {
+	t.Run("normalizes a URN string", func(t *testing.T) {
+		got, err := NormalizeString("urn:EXAMPLE:%7ea")
+		xt.OK(t, err)
+		xt.Eq(t, "urn:example:~a", got)
+	})
+
+	t.Run("empty input yields empty output", func(t *testing.T) {
+		got, err := NormalizeString("")
+		xt.OK(t, err)
+		xt.Eq(t, "", got)
+	})
+
+	t.Run("invalid URN returns error", func(t *testing.T) {
+		_, err := NormalizeString("not a urn")
+		xt.KO(t, err)
+	})
+}
+
+func TestURN_CanonicalString(t *testing.T) {
+	t.Run("excludes components from the canonical form", func(t *testing.T) {
+		u := mustParseURN("urn:EXAMPLE:%7ea#section-3")
+		xt.Eq(t, "urn:example:~a", u.CanonicalString())
+	})
+}
+
+func TestURN_Equal_RFC8141LexicalEquivalence(t *testing.T) {
+	t.Run("percent-encoded unreserved character equals its literal form", func(t *testing.T) {
+		u := mustParseURN("urn:example:%7ea")
+		o := mustParseURN("urn:example:~a")
+		xt.Assert(t, u.Equal(o))
+	})
+}
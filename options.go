@@ -12,6 +12,7 @@ type urnOptions struct {
 	fragment        *string
 	query           *string
 	notLowerCaseNSS bool
+	strictNID       bool
 }
 
 // WithFragment is a functional option setting the f-component of
@@ -49,3 +50,13 @@ func WithNotLowerCaseNSS() Option {
 		o.notLowerCaseNSS = true
 	}
 }
+
+// WithStrictNID makes New and Parse fail when the NID has a registered
+// NIDSpec (see RegisterNID) and that spec's Validate rejects the NSS.
+// Without this option, a registered spec is only used to normalize the
+// NSS; validation errors are ignored.
+func WithStrictNID() Option {
+	return func(o *urnOptions) {
+		o.strictNID = true
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2022, Geert JM Vanderkelen
+
+package urn
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value returns u as a driver.Value, satisfying driver.Valuer, so that
+// URN can be used as a first-class column type with database/sql. A zero
+// URN is stored as the empty string.
+func (u URN) Value() (driver.Value, error) {
+	if u.IsZero() {
+		return "", nil
+	}
+
+	return u.String(), nil
+}
+
+// Scan assigns a value from a database driver, satisfying sql.Scanner.
+// It accepts string, []byte, and nil; nil (SQL NULL) results in a zero URN.
+func (u *URN) Scan(src interface{}) error {
+	if src == nil {
+		*u = URN{}
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into URN", src)
+	}
+
+	if s == "" {
+		*u = URN{}
+		return nil
+	}
+
+	urn, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*u = *urn
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2022, Geert JM Vanderkelen
+
+package urn
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/geertjanvdk/xkit/xt"
+)
+
+func TestURN_Value(t *testing.T) {
+	t.Run("a URN value, not just a pointer, satisfies driver.Valuer", func(t *testing.T) {
+		u, err := Parse("urn:isbn:978-0135800911")
+		xt.OK(t, err)
+
+		var _ driver.Valuer = *u
+	})
+
+	t.Run("valid URN as driver value", func(t *testing.T) {
+		urn, err := Parse("urn:isbn:978-0135800911")
+		xt.OK(t, err)
+
+		v, err := urn.Value()
+		xt.OK(t, err)
+		xt.Eq(t, "urn:isbn:978-0135800911", v)
+	})
+
+	t.Run("zero URN as driver value", func(t *testing.T) {
+		var urn URN
+		v, err := urn.Value()
+		xt.OK(t, err)
+		xt.Eq(t, "", v)
+	})
+}
+
+func TestURN_Scan(t *testing.T) {
+	t.Run("scan string", func(t *testing.T) {
+		var urn URN
+		xt.OK(t, urn.Scan("urn:isbn:978-0135800911"))
+		xt.Eq(t, "isbn", urn.NID)
+		xt.Eq(t, "978-0135800911", urn.NSS)
+	})
+
+	t.Run("scan []byte", func(t *testing.T) {
+		var urn URN
+		xt.OK(t, urn.Scan([]byte("urn:isbn:978-0135800911")))
+		xt.Eq(t, "isbn", urn.NID)
+		xt.Eq(t, "978-0135800911", urn.NSS)
+	})
+
+	t.Run("scan nil as NULL", func(t *testing.T) {
+		urn := URN{NID: "isbn", NSS: "978-0135800911"}
+		xt.OK(t, urn.Scan(nil))
+		xt.Assert(t, urn.IsZero())
+	})
+
+	t.Run("scan empty string as zero URN", func(t *testing.T) {
+		urn := URN{NID: "isbn", NSS: "978-0135800911"}
+		xt.OK(t, urn.Scan(""))
+		xt.Assert(t, urn.IsZero())
+	})
+
+	t.Run("scan invalid URN", func(t *testing.T) {
+		var urn URN
+		xt.KO(t, urn.Scan("not a urn"))
+	})
+
+	t.Run("scan unsupported type", func(t *testing.T) {
+		var urn URN
+		xt.KO(t, urn.Scan(42))
+	})
+}
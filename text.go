@@ -0,0 +1,33 @@
+// Copyright (c) 2022, Geert JM Vanderkelen
+
+package urn
+
+// MarshalText returns the text encoding of u, which is simply its string
+// representation. This implements encoding.TextMarshaler so that URN values
+// round-trip through encoding/xml, encoding/gob, and any other package
+// keying off that interface.
+func (u URN) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText parses the text-encoded URN in text and stores the result
+// in u. It implements encoding.TextUnmarshaler.
+func (u *URN) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		// nothing to do
+		return nil
+	}
+
+	urn, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	if urn.IsZero() {
+		// we got nothing; nothing to assign
+		return nil
+	}
+
+	*u = *urn
+	return nil
+}
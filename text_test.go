@@ -0,0 +1,49 @@
+// Copyright (c) 2022, Geert JM Vanderkelen
+
+package urn
+
+import (
+	"encoding"
+	"testing"
+
+	"github.com/geertjanvdk/xkit/xt"
+)
+
+func TestURN_MarshalText(t *testing.T) {
+	t.Run("a URN value, not just a pointer, satisfies encoding.TextMarshaler", func(t *testing.T) {
+		u, err := Parse("urn:isbn:978-0135800911")
+		xt.OK(t, err)
+
+		var _ encoding.TextMarshaler = *u
+	})
+
+	t.Run("marshal URN as text", func(t *testing.T) {
+		urn, err := Parse("UrN:IsBn:978-0135800911#Chapter8")
+		xt.OK(t, err)
+		res, err := urn.MarshalText()
+		xt.OK(t, err)
+		xt.Eq(t, []byte("urn:isbn:978-0135800911#Chapter8"), res)
+	})
+}
+
+func TestURN_UnmarshalText(t *testing.T) {
+	t.Run("unmarshal text into URN", func(t *testing.T) {
+		var urn URN
+		xt.OK(t, urn.UnmarshalText([]byte("UrN:IsBn:978-0135800911#chapter1")))
+
+		xt.Eq(t, "isbn", urn.NID)
+		xt.Eq(t, "978-0135800911", urn.NSS)
+		xt.Eq(t, "chapter1", urn.FComponent())
+	})
+
+	t.Run("unmarshal text containing invalid URN", func(t *testing.T) {
+		var urn URN
+		xt.KO(t, urn.UnmarshalText([]byte("UrN:spaced:[with spaces]")))
+	})
+
+	t.Run("unmarshal empty text", func(t *testing.T) {
+		var urn URN
+		xt.OK(t, urn.UnmarshalText(nil))
+		xt.Eq(t, "", urn.String())
+	})
+}
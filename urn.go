@@ -22,7 +22,6 @@ var reURN = regexp.MustCompile(`^(?i)urn:(` + regexNID + `):(` + regexNSS + `)`
 	`(?:(\?+` + regexComponent + `))?` +
 	`(?:(\?=` + regexComponent + `))?` +
 	`(?:(#` + regexComponent + `))?$`)
-var reNormPerEnc = regexp.MustCompile(`(%[0-9a-f]{2})`)
 
 // URN is the representation of a URN as defined by RFC 8141.
 //
@@ -70,6 +69,12 @@ func New(nid, nss string, options ...Option) (*URN, error) {
 		urn.NID = strings.ToLower(nid)
 	}
 
+	nss, err := applyNIDSpec(urn.NID, urn.NSS, opts.strictNID)
+	if err != nil {
+		return nil, err
+	}
+	urn.NSS = nss
+
 	if opts.resolution != nil {
 		if err := urn.SetRComponent(*opts.resolution); err != nil {
 			return nil, err
@@ -156,25 +161,15 @@ func (u *URN) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Equal reports whether o and u represent the same URN.
+// Equal reports whether o and u represent the same URN according to the
+// RFC 8141 §3.1 lexical-equivalence rules applied by Normalize; the r-,
+// q-, and f-components are excluded from the comparison.
 func (u *URN) Equal(o *URN) bool {
 	if u == nil || o == nil {
 		panic("cannot check equivalent URN objects when both or either are nil")
 	}
 
-	uNSS := u.NSS
-	oNSS := o.NSS
-
-	// percent-encoded characters are considered case-insensitive, where the rest of the NSS is not
-	if strings.ContainsRune(uNSS, '%') {
-		uNSS = reNormPerEnc.ReplaceAllStringFunc(uNSS, strings.ToUpper)
-	}
-
-	if strings.ContainsRune(oNSS, '%') {
-		oNSS = reNormPerEnc.ReplaceAllStringFunc(oNSS, strings.ToUpper)
-	}
-
-	return strings.ToLower(u.NID) == strings.ToLower(o.NID) && uNSS == oNSS
+	return strings.ToLower(u.NID) == strings.ToLower(o.NID) && normalizeNSS(u.NSS) == normalizeNSS(o.NSS)
 }
 
 // IsZero reports whether u has NSS and NID set.
@@ -0,0 +1,85 @@
+// Copyright (c) 2022, Geert JM Vanderkelen
+
+// Package validate integrates this module's URN type with
+// github.com/go-playground/validator/v10, so struct fields can be
+// validated using the "urn" tag.
+package validate
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/golistic/urn"
+)
+
+// RegisterValidation registers the "urn" validation tag with v, and a
+// default English translation for it.
+//
+// A field tagged `validate:"urn"` must be a syntactically valid URN; a
+// field tagged `validate:"urn=<nid>"`, for example `validate:"urn=isbn"`,
+// must additionally have that NID (matched case-insensitively, using the
+// NID registry from RegisterNID when one is registered for it). Tagged
+// fields may be of kind string, *string, urn.URN, or *urn.URN; a nil
+// pointer fails validation unless the field is also tagged "omitempty".
+func RegisterValidation(v *validator.Validate) error {
+	if err := v.RegisterValidation("urn", validateURN); err != nil {
+		return err
+	}
+
+	uni := ut.New(en.New(), en.New())
+	trans, _ := uni.GetTranslator("en")
+
+	return v.RegisterTranslation("urn", trans,
+		func(t ut.Translator) error {
+			return t.Add("urn", "{0} must be a valid URN", true)
+		},
+		func(t ut.Translator, fe validator.FieldError) string {
+			msg, _ := t.T("urn", fe.Field())
+			return msg
+		},
+	)
+}
+
+func validateURN(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return false
+		}
+		field = field.Elem()
+	}
+
+	var s string
+	switch v := field.Interface().(type) {
+	case string:
+		s = v
+	case urn.URN:
+		s = v.String()
+	default:
+		return false
+	}
+
+	wantNID := fl.Param()
+
+	var opts []urn.Option
+	if wantNID != "" {
+		// only enforce the registered NIDSpec when a specific NID was
+		// requested; a bare "urn" tag just needs a syntactically valid URN.
+		opts = append(opts, urn.WithStrictNID())
+	}
+
+	u, err := urn.Parse(s, opts...)
+	if err != nil || u.IsZero() {
+		return false
+	}
+
+	if wantNID != "" && !strings.EqualFold(u.NID, wantNID) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2022, Geert JM Vanderkelen
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/geertjanvdk/xkit/xt"
+	"github.com/golistic/urn"
+)
+
+func newValidator(t *testing.T) *validator.Validate {
+	t.Helper()
+	v := validator.New()
+	xt.OK(t, RegisterValidation(v))
+	return v
+}
+
+func TestRegisterValidation(t *testing.T) {
+	v := newValidator(t)
+
+	t.Run("string field", func(t *testing.T) {
+		type data struct {
+			URN string `validate:"urn"`
+		}
+
+		xt.OK(t, v.Struct(data{URN: "urn:isbn:978-0135800911"}))
+		xt.KO(t, v.Struct(data{URN: "not a urn"}))
+	})
+
+	t.Run("*string field", func(t *testing.T) {
+		type data struct {
+			URN *string `validate:"urn"`
+		}
+
+		s := "urn:isbn:978-0135800911"
+		xt.OK(t, v.Struct(data{URN: &s}))
+		xt.KO(t, v.Struct(data{URN: nil}))
+	})
+
+	t.Run("URN field", func(t *testing.T) {
+		type data struct {
+			URN urn.URN `validate:"urn"`
+		}
+
+		u, err := urn.Parse("urn:isbn:978-0135800911")
+		xt.OK(t, err)
+		xt.OK(t, v.Struct(data{URN: *u}))
+	})
+
+	t.Run("*URN field", func(t *testing.T) {
+		type data struct {
+			URN *urn.URN `validate:"urn"`
+		}
+
+		u, err := urn.Parse("urn:isbn:978-0135800911")
+		xt.OK(t, err)
+		xt.OK(t, v.Struct(data{URN: u}))
+		xt.KO(t, v.Struct(data{URN: nil}))
+	})
+
+	t.Run("urn=nid requires that NID", func(t *testing.T) {
+		type data struct {
+			URN string `validate:"urn=isbn"`
+		}
+
+		xt.OK(t, v.Struct(data{URN: "urn:isbn:978-0135800911"}))
+		xt.KO(t, v.Struct(data{URN: "urn:issn:2049-3630"}))
+	})
+
+	t.Run("bare urn tag only requires a syntactically valid URN", func(t *testing.T) {
+		type data struct {
+			URN string `validate:"urn"`
+		}
+
+		// bad ISBN-13 check digit, but still a well-formed URN; the
+		// registered NIDSpec must not be enforced without urn=<nid>.
+		xt.OK(t, v.Struct(data{URN: "urn:isbn:978-0135800912"}))
+	})
+
+	t.Run("urn=nid also enforces the registered NIDSpec", func(t *testing.T) {
+		type data struct {
+			URN string `validate:"urn=isbn"`
+		}
+
+		// NID label matches but the ISBN-13 check digit is wrong.
+		xt.KO(t, v.Struct(data{URN: "urn:isbn:978-0135800912"}))
+	})
+}